@@ -0,0 +1,35 @@
+// Command computus-ical emits a year's worth of liturgical feasts, resolved
+// against the bundled universal calendar, as an RFC 5545 VCALENDAR stream
+// on stdout.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/CodeZea1ot/computus/ical"
+	"github.com/CodeZea1ot/computus/liturgy"
+	"github.com/CodeZea1ot/computus/rubric"
+	"github.com/CodeZea1ot/computus/sanctoral"
+	"github.com/CodeZea1ot/computus/temporal"
+)
+
+func main() {
+	year := flag.Int("year", time.Now().Year(), "civil year to export")
+	minRank := flag.String("min-rank", "", "minimum rubric.Rank of sanctoral feast to include (blank = no filter)")
+	flag.Parse()
+
+	t := temporal.NewTemporale(*year)
+	cal := liturgy.NewCalendar(t, sanctoral.Universal())
+
+	from := time.Date(*year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(*year, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	opts := ical.Options{Sources: ical.All, MinRank: rubric.Rank(*minRank)}
+	if err := ical.Export(os.Stdout, from, to, cal, opts); err != nil {
+		fmt.Fprintln(os.Stderr, "computus-ical:", err)
+		os.Exit(1)
+	}
+}
@@ -19,13 +19,19 @@ const (
 
 // RelativeToEasterDays represents a collection of movable feasts/fasts whose dates are relative to the date of Easter
 var RelativeToEasterDays = []RelativeToEaster{
+	{"Fat Thursday", -52},
 	{"Septuagesima Sunday", -63},
 	{"Sexagesima Sunday", -56},
 	{"Quinguagesima Sunday", -49},
+	{"Fat Tuesday", -47},
 	{"Ash Wednesday", -46},
 	{EmberWedLent, -39},
 	{EmberFriLent, -37},
 	{EmberSatLent, -36},
+	{"First Sunday of Lent", -42},
+	{"Second Sunday of Lent", -35},
+	{"Third Sunday of Lent", -28},
+	{"Laetare Sunday", -21},
 	{"Passion Sunday", -14},
 	{"Palm Sunday", -7},
 	{"Spy Wednesday", -4},
@@ -34,14 +40,22 @@ var RelativeToEasterDays = []RelativeToEaster{
 	{"Holy Saturday", -1},
 	{"Easter Monday", 1},
 	{"Easter Tuesday", 2},
+	{"Easter Wednesday", 3},
+	{"Easter Thursday", 4},
+	{"Easter Friday", 5},
+	{"Easter Saturday", 6},
 	{"The Octave of Easter (Low Sunday)", 7},
 	{"Ascension", 39},
 	{"Pentecost", 49},
+	{"Pentecost Monday", 50},
+	{"Pentecost Tuesday", 51},
 	{EmberWedPent, 52},
+	{"Pentecost Thursday", 53},
 	{EmberFriPent, 54},
 	{EmberSatPent, 55},
 	{"Trinity Sunday", 56},
 	{"Corpus Christi", 60},
+	{"Feast of the Sacred Heart", 68},
 }
 
 // Easter returns the date of Easter Sunday for the given year
@@ -97,6 +111,17 @@ func mustRelativeToEaster(year int, name string) time.Time {
 	panic("computus: unknown feast/fast: " + name)
 }
 
+// RelativeToEasterDate returns the date of a movable feast or fast for the
+// given year, calculated by applying the feast's offset relative to Easter
+// Sunday. It lets callers look up a feast by name from config or data
+// files, without needing a dedicated helper function for it.
+//
+// The boolean return value reports whether the provided name matches a
+// known feast or fast defined in RelativeToEasterDays.
+func RelativeToEasterDate(year int, name string) (time.Time, bool) {
+	return relativeToEaster(year, name)
+}
+
 // AshWednesday calculates the date of Ash Wednesday for a given year
 func AshWednesday(year int) time.Time { return mustRelativeToEaster(year, "Ash Wednesday") }
 
@@ -172,3 +197,221 @@ func EmberFridayPentecost(year int) time.Time {
 func EmberSaturdayPentecost(year int) time.Time {
 	return mustRelativeToEaster(year, EmberSatPent)
 }
+
+// FatThursday calculates the date of Fat Thursday for a given year
+func FatThursday(year int) time.Time { return mustRelativeToEaster(year, "Fat Thursday") }
+
+// FatTuesday calculates the date of Fat Tuesday (Shrove Tuesday, Mardi Gras) for a given year
+func FatTuesday(year int) time.Time { return mustRelativeToEaster(year, "Fat Tuesday") }
+
+// FirstSundayOfLent calculates the date of the First Sunday of Lent for a given year
+func FirstSundayOfLent(year int) time.Time {
+	return mustRelativeToEaster(year, "First Sunday of Lent")
+}
+
+// SecondSundayOfLent calculates the date of the Second Sunday of Lent for a given year
+func SecondSundayOfLent(year int) time.Time {
+	return mustRelativeToEaster(year, "Second Sunday of Lent")
+}
+
+// ThirdSundayOfLent calculates the date of the Third Sunday of Lent for a given year
+func ThirdSundayOfLent(year int) time.Time {
+	return mustRelativeToEaster(year, "Third Sunday of Lent")
+}
+
+// LaetareSunday calculates the date of Laetare Sunday (the Fourth Sunday of Lent) for a given year
+func LaetareSunday(year int) time.Time { return mustRelativeToEaster(year, "Laetare Sunday") }
+
+// EasterWednesday calculates the date of Easter Wednesday for a given year
+func EasterWednesday(year int) time.Time { return mustRelativeToEaster(year, "Easter Wednesday") }
+
+// EasterThursday calculates the date of Easter Thursday for a given year
+func EasterThursday(year int) time.Time { return mustRelativeToEaster(year, "Easter Thursday") }
+
+// EasterFriday calculates the date of Easter Friday for a given year
+func EasterFriday(year int) time.Time { return mustRelativeToEaster(year, "Easter Friday") }
+
+// EasterSaturday calculates the date of Easter Saturday for a given year
+func EasterSaturday(year int) time.Time { return mustRelativeToEaster(year, "Easter Saturday") }
+
+// PentecostMonday calculates the date of Pentecost Monday (Whit Monday) for a given year
+func PentecostMonday(year int) time.Time { return mustRelativeToEaster(year, "Pentecost Monday") }
+
+// PentecostTuesday calculates the date of Pentecost Tuesday for a given year
+func PentecostTuesday(year int) time.Time { return mustRelativeToEaster(year, "Pentecost Tuesday") }
+
+// SacredHeart calculates the date of the Feast of the Sacred Heart for a given year
+func SacredHeart(year int) time.Time { return mustRelativeToEaster(year, "Feast of the Sacred Heart") }
+
+// Method identifies which computus algorithm is used to calculate the date
+// of Easter, and by extension every feast/fast in RelativeToEasterDays.
+type Method int
+
+const (
+	// Gregorian is the Anonymous Gregorian Algorithm used by the Western
+	// churches. Western is an alias of this value.
+	Gregorian Method = iota
+	// Orthodox is the Julian-rule computus used by most Eastern Orthodox
+	// churches, converted to the proleptic Gregorian calendar for use
+	// alongside Gregorian dates. Eastern is an alias of this value.
+	Orthodox
+	// Julian is the same Julian-rule computus as Orthodox, but returned as
+	// a raw Julian-calendar date with no Gregorian conversion applied.
+	Julian
+)
+
+// Western is an alias for Gregorian, matching common usage.
+const Western = Gregorian
+
+// Eastern is an alias for Orthodox, matching common usage.
+const Eastern = Orthodox
+
+// easterJulianOrdinal returns the month and day of Easter Sunday in the
+// Julian calendar for the given year, using Meeus' Julian-rule algorithm.
+func easterJulianOrdinal(year int) (month time.Month, day int) {
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+
+	month = time.Month((d + e + 114) / 31)
+	day = ((d + e + 114) % 31) + 1
+	return month, day
+}
+
+// julianToGregorianOffset returns the number of days the Julian calendar
+// lags behind the Gregorian calendar for the given year. Valid for years
+// 200 and later; it is 13 days throughout the 20th and 21st centuries.
+func julianToGregorianOffset(year int) int {
+	return year/100 - year/400 - 2
+}
+
+// EasterJulian returns the date of Easter Sunday according to the
+// Julian-rule computus used by most Eastern Orthodox churches, converted to
+// the proleptic Gregorian calendar.
+// ref: https://en.wikipedia.org/wiki/Date_of_Easter#Julian_calendar
+func EasterJulian(year int) time.Time {
+	month, day := easterJulianOrdinal(year)
+	julian := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return julian.AddDate(0, 0, julianToGregorianOffset(year))
+}
+
+// EasterWithMethod returns the date of Easter Sunday for the given year
+// according to the specified Method.
+//
+// Gregorian uses the Anonymous Gregorian Algorithm. Orthodox uses the
+// Julian-rule computus converted to the proleptic Gregorian calendar.
+// Julian returns the raw Julian-calendar date, with no Gregorian conversion
+// applied, for callers working entirely within the Julian calendar.
+func EasterWithMethod(year int, m Method) time.Time {
+	switch m {
+	case Orthodox:
+		return EasterJulian(year)
+	case Julian:
+		month, day := easterJulianOrdinal(year)
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	default:
+		return Easter(year)
+	}
+}
+
+// relativeToEasterWithMethod returns the date of a movable feast or fast for
+// the given year and Method, by applying the feast's offset relative to
+// Easter Sunday as calculated by that method.
+func relativeToEasterWithMethod(year int, name string, m Method) (time.Time, bool) {
+	for _, r := range RelativeToEasterDays {
+		if r.Name == name {
+			return EasterWithMethod(year, m).AddDate(0, 0, r.Offset), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// mustRelativeToEasterWithMethod is the Method-aware counterpart of
+// mustRelativeToEaster. It panics if the provided name does not correspond
+// to a known feast or fast.
+func mustRelativeToEasterWithMethod(year int, name string, m Method) time.Time {
+	if d, ok := relativeToEasterWithMethod(year, name, m); ok {
+		return d
+	}
+	panic("computus: unknown feast/fast: " + name)
+}
+
+// RelativeToEasterWithMethod returns the date of a movable feast or fast for
+// the given year and Method, calculated by applying the feast's offset
+// relative to Easter Sunday as calculated by that method. This lets callers
+// compute, for example, Orthodox Pentecost via
+// RelativeToEasterWithMethod(year, "Pentecost", Orthodox).
+//
+// The boolean return value reports whether the provided name matches a
+// known feast or fast defined in RelativeToEasterDays.
+func RelativeToEasterWithMethod(year int, name string, m Method) (time.Time, bool) {
+	return relativeToEasterWithMethod(year, name, m)
+}
+
+// AshWednesdayWithMethod calculates the date of Ash Wednesday for a given
+// year according to the specified Method.
+func AshWednesdayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Ash Wednesday", m)
+}
+
+// PalmSundayWithMethod calculates the date of Palm Sunday for a given year
+// according to the specified Method.
+func PalmSundayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Palm Sunday", m)
+}
+
+// SpyWednesdayWithMethod calculates the date of Spy Wednesday for a given
+// year according to the specified Method.
+func SpyWednesdayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Spy Wednesday", m)
+}
+
+// HolyThursdayWithMethod calculates the date of Holy Thursday for a given
+// year according to the specified Method.
+func HolyThursdayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Holy Thursday", m)
+}
+
+// GoodFridayWithMethod calculates the date of Good Friday for a given year
+// according to the specified Method.
+func GoodFridayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Good Friday", m)
+}
+
+// HolySaturdayWithMethod calculates the date of Holy Saturday for a given
+// year according to the specified Method.
+func HolySaturdayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Holy Saturday", m)
+}
+
+// EasterMondayWithMethod calculates the date of Easter Monday for a given
+// year according to the specified Method.
+func EasterMondayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Easter Monday", m)
+}
+
+// AscensionWithMethod calculates the date of Ascension for a given year
+// according to the specified Method.
+func AscensionWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Ascension", m)
+}
+
+// PentecostWithMethod calculates the date of Pentecost for a given year
+// according to the specified Method.
+func PentecostWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Pentecost", m)
+}
+
+// TrinitySundayWithMethod calculates the date of Trinity Sunday for a given
+// year according to the specified Method.
+func TrinitySundayWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Trinity Sunday", m)
+}
+
+// CorpusChristiWithMethod calculates the date of Corpus Christi for a given
+// year according to the specified Method.
+func CorpusChristiWithMethod(year int, m Method) time.Time {
+	return mustRelativeToEasterWithMethod(year, "Corpus Christi", m)
+}
@@ -283,3 +283,202 @@ func TestAscensionInRange(t *testing.T) {
 		}
 	}
 }
+
+// verifiedOrthodoxEasterDates contains historically verified Eastern
+// Orthodox (Julian-rule) Easter Sunday dates, expressed on the proleptic
+// Gregorian calendar.
+var verifiedOrthodoxEasterDates = map[int]string{
+	2024: "2024-05-05",
+	2025: "2025-04-20",
+	2026: "2026-04-12",
+}
+
+// TestEasterJulian verifies that EasterJulian returns the correct,
+// historically verified Orthodox Easter Sunday dates for a selection of
+// known years.
+func TestEasterJulian(t *testing.T) {
+	for year, expected := range verifiedOrthodoxEasterDates {
+		got := EasterJulian(year).Format("2006-01-02")
+		if got != expected {
+			t.Errorf("EasterJulian(%d) = %s, want %s", year, got, expected)
+		}
+	}
+}
+
+// TestEasterJulianInRange ensures that EasterJulian always returns a Sunday
+// for every year in the supported range.
+func TestEasterJulianInRange(t *testing.T) {
+	for year := 1583; year <= 3000; year++ {
+		e := EasterJulian(year)
+		if e.Weekday() != time.Sunday {
+			t.Fatalf("EasterJulian(%d) = %v, want a Sunday", year, e)
+		}
+	}
+}
+
+// TestEasterWithMethod verifies that EasterWithMethod dispatches to the
+// correct algorithm for Gregorian and Orthodox methods.
+func TestEasterWithMethod(t *testing.T) {
+	for year, expected := range verifiedEasterDates {
+		got := EasterWithMethod(year, Gregorian).Format("2006-01-02")
+		if got != expected {
+			t.Errorf("EasterWithMethod(%d, Gregorian) = %s, want %s", year, got, expected)
+		}
+		if got := EasterWithMethod(year, Western).Format("2006-01-02"); got != expected {
+			t.Errorf("EasterWithMethod(%d, Western) = %s, want %s", year, got, expected)
+		}
+	}
+	for year, expected := range verifiedOrthodoxEasterDates {
+		got := EasterWithMethod(year, Orthodox).Format("2006-01-02")
+		if got != expected {
+			t.Errorf("EasterWithMethod(%d, Orthodox) = %s, want %s", year, got, expected)
+		}
+		if got := EasterWithMethod(year, Eastern).Format("2006-01-02"); got != expected {
+			t.Errorf("EasterWithMethod(%d, Eastern) = %s, want %s", year, got, expected)
+		}
+	}
+}
+
+// TestEasterWithMethodJulianIsUnconverted verifies that the Julian method
+// returns the raw Julian-calendar ordinal, which lags the Gregorian-
+// converted Orthodox date by the calendar's day offset for that year.
+func TestEasterWithMethodJulianIsUnconverted(t *testing.T) {
+	for year := range verifiedOrthodoxEasterDates {
+		julian := EasterWithMethod(year, Julian)
+		orthodox := EasterWithMethod(year, Orthodox)
+
+		diff := int(orthodox.Sub(julian).Hours() / 24)
+		if diff != julianToGregorianOffset(year) {
+			t.Errorf("Orthodox(%d) - Julian(%d) = %d days, want %d", year, year, diff, julianToGregorianOffset(year))
+		}
+	}
+}
+
+// TestAshWednesdayWithMethodOrthodox verifies that the Orthodox method
+// computes Ash Wednesday 46 days before the Orthodox Easter date.
+func TestAshWednesdayWithMethodOrthodox(t *testing.T) {
+	for year := range verifiedOrthodoxEasterDates {
+		expected := EasterJulian(year).AddDate(0, 0, -46).Format("2006-01-02")
+		got := AshWednesdayWithMethod(year, Orthodox).Format("2006-01-02")
+		if got != expected {
+			t.Errorf("AshWednesdayWithMethod(%d, Orthodox) = %s, want %s", year, got, expected)
+		}
+	}
+}
+
+// TestPentecostWithMethodOrthodox verifies that the Orthodox method
+// computes Pentecost 49 days after the Orthodox Easter date.
+func TestPentecostWithMethodOrthodox(t *testing.T) {
+	for year := range verifiedOrthodoxEasterDates {
+		expected := EasterJulian(year).AddDate(0, 0, 49).Format("2006-01-02")
+		got := PentecostWithMethod(year, Orthodox).Format("2006-01-02")
+		if got != expected {
+			t.Errorf("PentecostWithMethod(%d, Orthodox) = %s, want %s", year, got, expected)
+		}
+	}
+}
+
+// TestRelativeToEasterWithMethodUnknownFeast verifies that
+// RelativeToEasterWithMethod reports no match for an unknown feast name.
+func TestRelativeToEasterWithMethodUnknownFeast(t *testing.T) {
+	if _, ok := RelativeToEasterWithMethod(2026, "Nonexistent Feast", Orthodox); ok {
+		t.Errorf("RelativeToEasterWithMethod reported a match for an unknown feast")
+	}
+}
+
+// checkOffsetFromEaster validates that the named feast/fast falls on the
+// correct number of days from Easter Sunday for every year in range.
+func checkOffsetFromEaster(t *testing.T, name string) {
+	var offset int
+	found := false
+	for _, r := range RelativeToEasterDays {
+		if r.Name == name {
+			offset = r.Offset
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("feast %q not found in RelativeToEasterDays", name)
+	}
+
+	for year := 1583; year <= 3000; year++ {
+		got := mustRelativeToEaster(year, name)
+		easter := Easter(year)
+
+		diff := int(got.Sub(easter).Hours() / 24)
+		if diff != offset {
+			t.Fatalf("%s(%d) is %d days from Easter, want %d", name, year, diff, offset)
+		}
+	}
+}
+
+// TestRelativeToEasterDaysInRange exercises every entry in
+// RelativeToEasterDays, including the pre-Lenten days, Sundays of Lent, and
+// days of the Easter and Pentecost octaves added alongside the existing
+// entries, across the full supported year range.
+func TestRelativeToEasterDaysInRange(t *testing.T) {
+	for _, r := range RelativeToEasterDays {
+		checkOffsetFromEaster(t, r.Name)
+	}
+}
+
+// TestFatTuesdayAndLaetareSunday spot-checks the new FatTuesday (pre-Lenten)
+// and LaetareSunday (Lenten) helpers against a selection of known Easter dates.
+func TestFatTuesdayAndLaetareSunday(t *testing.T) {
+	for year, easterStr := range verifiedEasterDates {
+		easter, _ := time.Parse("2006-01-02", easterStr)
+
+		if got, want := FatTuesday(year).Format("2006-01-02"), easter.AddDate(0, 0, -47).Format("2006-01-02"); got != want {
+			t.Errorf("FatTuesday(%d) = %s, want %s", year, got, want)
+		}
+		if got, want := LaetareSunday(year).Format("2006-01-02"), easter.AddDate(0, 0, -21).Format("2006-01-02"); got != want {
+			t.Errorf("LaetareSunday(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+// TestEasterOctaveWeekdays verifies that the newly added Easter
+// Wednesday-Saturday entries fall where expected within the Octave of
+// Easter.
+func TestEasterOctaveWeekdays(t *testing.T) {
+	helpers := map[string]func(int) time.Time{
+		"Easter Wednesday": EasterWednesday,
+		"Easter Thursday":  EasterThursday,
+		"Easter Friday":    EasterFriday,
+		"Easter Saturday":  EasterSaturday,
+	}
+	offsets := map[string]int{
+		"Easter Wednesday": 3,
+		"Easter Thursday":  4,
+		"Easter Friday":    5,
+		"Easter Saturday":  6,
+	}
+
+	for year := range verifiedEasterDates {
+		easter := Easter(year)
+		for name, fn := range helpers {
+			want := easter.AddDate(0, 0, offsets[name]).Format("2006-01-02")
+			if got := fn(year).Format("2006-01-02"); got != want {
+				t.Errorf("%s(%d) = %s, want %s", name, year, got, want)
+			}
+		}
+	}
+}
+
+// TestRelativeToEasterExportedWrapper verifies that the exported
+// RelativeToEasterDate wrapper behaves like the unexported implementation it
+// delegates to.
+func TestRelativeToEasterExportedWrapper(t *testing.T) {
+	got, ok := RelativeToEasterDate(2026, "Pentecost")
+	if !ok {
+		t.Fatal("RelativeToEasterDate(2026, \"Pentecost\") reported no match")
+	}
+	if want := Pentecost(2026); !got.Equal(want) {
+		t.Errorf("RelativeToEasterDate(2026, \"Pentecost\") = %v, want %v", got, want)
+	}
+
+	if _, ok := RelativeToEasterDate(2026, "Nonexistent Feast"); ok {
+		t.Error("RelativeToEasterDate reported a match for an unknown feast")
+	}
+}
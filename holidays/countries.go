@@ -0,0 +1,110 @@
+package holidays
+
+import "github.com/CodeZea1ot/computus"
+
+// Germany is the German (DE) national public-holiday bundle. Public
+// holidays falling on a weekend are not substituted.
+var Germany = Bundle{defs: []definition{
+	{name: "New Year's Day", typ: Civil, month: 1, day: 1},
+	{name: "Good Friday", typ: Religious, relativeToEaster: true, offset: -2, method: computus.Western},
+	{name: "Easter Monday", typ: Religious, relativeToEaster: true, offset: 1, method: computus.Western},
+	{name: "Labour Day", typ: Civil, month: 5, day: 1},
+	{name: "Ascension Day", typ: Religious, relativeToEaster: true, offset: 39, method: computus.Western},
+	{name: "Whit Monday", typ: Religious, relativeToEaster: true, offset: 50, method: computus.Western},
+	{name: "German Unity Day", typ: Civil, month: 10, day: 3},
+	{name: "Christmas Day", typ: Religious, month: 12, day: 25},
+	{name: "St. Stephen's Day", typ: Religious, month: 12, day: 26},
+}}
+
+// Poland is the Polish (PL) national public-holiday bundle. Public
+// holidays falling on a weekend are not substituted.
+var Poland = Bundle{defs: []definition{
+	{name: "New Year's Day", typ: Civil, month: 1, day: 1},
+	{name: "Epiphany", typ: Religious, month: 1, day: 6},
+	{name: "Easter Sunday", typ: Religious, relativeToEaster: true, offset: 0, method: computus.Western},
+	{name: "Easter Monday", typ: Religious, relativeToEaster: true, offset: 1, method: computus.Western},
+	{name: "Labour Day", typ: Civil, month: 5, day: 1},
+	{name: "Constitution Day", typ: Civil, month: 5, day: 3},
+	{name: "Pentecost Sunday", typ: Religious, relativeToEaster: true, offset: 49, method: computus.Western},
+	{name: "Corpus Christi", typ: Religious, relativeToEaster: true, offset: 60, method: computus.Western},
+	{name: "Assumption of Mary", typ: Religious, month: 8, day: 15},
+	{name: "All Saints' Day", typ: Religious, month: 11, day: 1},
+	{name: "Independence Day", typ: Civil, month: 11, day: 11},
+	{name: "Christmas Day", typ: Religious, month: 12, day: 25},
+	{name: "St. Stephen's Day", typ: Religious, month: 12, day: 26},
+}}
+
+// Bulgaria is the Bulgarian (BG) national public-holiday bundle. As an
+// Orthodox-majority country, its Easter-relative holidays use the
+// computus.Orthodox method rather than computus.Western. Public holidays
+// falling on a weekend are not substituted.
+var Bulgaria = Bundle{defs: []definition{
+	{name: "Liberation Day", typ: Civil, month: 3, day: 3},
+	{name: "Good Friday", typ: Religious, relativeToEaster: true, offset: -2, method: computus.Orthodox},
+	{name: "Holy Saturday", typ: Religious, relativeToEaster: true, offset: -1, method: computus.Orthodox},
+	{name: "Easter Sunday", typ: Religious, relativeToEaster: true, offset: 0, method: computus.Orthodox},
+	{name: "Easter Monday", typ: Religious, relativeToEaster: true, offset: 1, method: computus.Orthodox},
+	{name: "Labour Day", typ: Civil, month: 5, day: 1},
+	{name: "St. George's Day", typ: Civil, month: 5, day: 6},
+	{name: "Education and Culture Day", typ: Civil, month: 5, day: 24},
+	{name: "Unification Day", typ: Civil, month: 9, day: 6},
+	{name: "Independence Day", typ: Civil, month: 9, day: 22},
+	{name: "Christmas Day", typ: Religious, month: 12, day: 25},
+	{name: "St. Stephen's Day", typ: Religious, month: 12, day: 26},
+}}
+
+// Italy is the Italian (IT) national public-holiday bundle. Public
+// holidays falling on a weekend are not substituted.
+var Italy = Bundle{defs: []definition{
+	{name: "New Year's Day", typ: Civil, month: 1, day: 1},
+	{name: "Epiphany", typ: Religious, month: 1, day: 6},
+	{name: "Easter Sunday", typ: Religious, relativeToEaster: true, offset: 0, method: computus.Western},
+	{name: "Easter Monday", typ: Religious, relativeToEaster: true, offset: 1, method: computus.Western},
+	{name: "Liberation Day", typ: Civil, month: 4, day: 25},
+	{name: "Labour Day", typ: Civil, month: 5, day: 1},
+	{name: "Republic Day", typ: Civil, month: 6, day: 2},
+	{name: "Assumption of Mary", typ: Religious, month: 8, day: 15},
+	{name: "All Saints' Day", typ: Religious, month: 11, day: 1},
+	{name: "Immaculate Conception", typ: Religious, month: 12, day: 8},
+	{name: "Christmas Day", typ: Religious, month: 12, day: 25},
+	{name: "St. Stephen's Day", typ: Religious, month: 12, day: 26},
+}}
+
+// France is the French (FR) national public-holiday bundle. Public
+// holidays falling on a weekend are not substituted.
+var France = Bundle{defs: []definition{
+	{name: "New Year's Day", typ: Civil, month: 1, day: 1},
+	{name: "Easter Monday", typ: Religious, relativeToEaster: true, offset: 1, method: computus.Western},
+	{name: "Labour Day", typ: Civil, month: 5, day: 1},
+	{name: "Victory in Europe Day", typ: Civil, month: 5, day: 8},
+	{name: "Ascension Day", typ: Religious, relativeToEaster: true, offset: 39, method: computus.Western},
+	{name: "Whit Monday", typ: Religious, relativeToEaster: true, offset: 50, method: computus.Western},
+	{name: "Bastille Day", typ: Civil, month: 7, day: 14},
+	{name: "Assumption of Mary", typ: Religious, month: 8, day: 15},
+	{name: "All Saints' Day", typ: Religious, month: 11, day: 1},
+	{name: "Armistice Day", typ: Civil, month: 11, day: 11},
+	{name: "Christmas Day", typ: Religious, month: 12, day: 25},
+}}
+
+// UnitedKingdom is the British (GB) national public-holiday bundle, limited
+// to its fixed-date and Easter-relative holidays. Holidays that fall on a
+// weekend are observed the following Monday.
+var UnitedKingdom = Bundle{defs: []definition{
+	{name: "New Year's Day", typ: Civil, month: 1, day: 1, substitute: saturdayOrSundayToMonday},
+	{name: "Good Friday", typ: Religious, relativeToEaster: true, offset: -2, method: computus.Western},
+	{name: "Easter Monday", typ: Religious, relativeToEaster: true, offset: 1, method: computus.Western},
+	{name: "Christmas Day", typ: Civil, month: 12, day: 25, substitute: saturdayOrSundayToMonday},
+	{name: "Boxing Day", typ: Civil, month: 12, day: 26, substitute: saturdayOrSundayToMonday},
+}}
+
+// UnitedStates is the United States (US) federal public-holiday bundle,
+// limited to its fixed-date holidays. Holidays that fall on a Saturday are
+// observed the preceding Friday, and holidays that fall on a Sunday are
+// observed the following Monday.
+var UnitedStates = Bundle{defs: []definition{
+	{name: "New Year's Day", typ: Civil, month: 1, day: 1, substitute: saturdayToPrecedingFridayOrSundayToMonday},
+	{name: "Juneteenth", typ: Civil, month: 6, day: 19, substitute: saturdayToPrecedingFridayOrSundayToMonday},
+	{name: "Independence Day", typ: Civil, month: 7, day: 4, substitute: saturdayToPrecedingFridayOrSundayToMonday},
+	{name: "Veterans Day", typ: Civil, month: 11, day: 11, substitute: saturdayToPrecedingFridayOrSundayToMonday},
+	{name: "Christmas Day", typ: Religious, month: 12, day: 25, substitute: saturdayToPrecedingFridayOrSundayToMonday},
+}}
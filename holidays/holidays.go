@@ -0,0 +1,108 @@
+// Package holidays builds country-specific public-holiday calendars on top
+// of the moveable feasts computed by the computus package, including
+// weekend-substitution rules where a country observes them.
+package holidays
+
+import (
+	"time"
+
+	"github.com/CodeZea1ot/computus"
+)
+
+// Type distinguishes a civil public holiday from one of religious origin.
+type Type int
+
+const (
+	Civil Type = iota
+	Religious
+)
+
+// Holiday is a single resolved public holiday for a given year.
+type Holiday struct {
+	Name     string    // Name of the holiday
+	Date     time.Time // The actual date of the holiday
+	Observed time.Time // The date on which the day off is taken, after any weekend substitution
+	Type     Type
+}
+
+// substitution maps an actual holiday date to the date observed in lieu of
+// it, e.g. "if Saturday, observe Monday". A nil substitution leaves
+// Observed equal to Date.
+type substitution func(time.Time) time.Time
+
+// noSubstitution is used by countries that do not move a holiday's
+// observance when it falls on a weekend.
+func noSubstitution(d time.Time) time.Time { return d }
+
+// saturdayOrSundayToMonday observes a holiday on the following Monday when
+// it falls on a Saturday or a Sunday, as several Anglophone countries do.
+func saturdayOrSundayToMonday(d time.Time) time.Time {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, 2)
+	case time.Sunday:
+		return d.AddDate(0, 0, 1)
+	default:
+		return d
+	}
+}
+
+// saturdayToPrecedingFridayOrSundayToMonday observes a holiday on the
+// preceding Friday when it falls on a Saturday, and on the following Monday
+// when it falls on a Sunday, as US federal holidays do.
+func saturdayToPrecedingFridayOrSundayToMonday(d time.Time) time.Time {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, -1)
+	case time.Sunday:
+		return d.AddDate(0, 0, 1)
+	default:
+		return d
+	}
+}
+
+// definition describes one holiday's date rule: either a fixed month/day,
+// or an offset relative to Easter Sunday as calculated by method.
+type definition struct {
+	name       string
+	typ        Type
+	substitute substitution
+
+	month, day int
+
+	relativeToEaster bool
+	offset           int
+	method           computus.Method
+}
+
+func (d definition) dateFor(year int) time.Time {
+	if d.relativeToEaster {
+		return computus.EasterWithMethod(year, d.method).AddDate(0, 0, d.offset)
+	}
+	return time.Date(year, time.Month(d.month), d.day, 0, 0, 0, 0, time.UTC)
+}
+
+// Bundle is a country's set of public-holiday rules.
+type Bundle struct {
+	defs []definition
+}
+
+// Observed returns every holiday in the bundle, resolved for the given
+// year, including weekend substitution where the bundle defines one.
+func (b Bundle) Observed(year int) []Holiday {
+	out := make([]Holiday, 0, len(b.defs))
+	for _, d := range b.defs {
+		date := d.dateFor(year)
+		substitute := d.substitute
+		if substitute == nil {
+			substitute = noSubstitution
+		}
+		out = append(out, Holiday{
+			Name:     d.name,
+			Date:     date,
+			Observed: substitute(date),
+			Type:     d.typ,
+		})
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+package holidays
+
+import (
+	"testing"
+	"time"
+
+	"github.com/CodeZea1ot/computus"
+)
+
+func TestPolandEasterMondayMatchesComputus(t *testing.T) {
+	year := 2026
+	want := computus.EasterMonday(year).Format("2006-01-02")
+
+	for _, h := range Poland.Observed(year) {
+		if h.Name == "Easter Monday" {
+			if got := h.Date.Format("2006-01-02"); got != want {
+				t.Errorf("Poland Easter Monday = %s, want %s", got, want)
+			}
+			return
+		}
+	}
+	t.Fatal("Poland.Observed did not include Easter Monday")
+}
+
+func TestBulgariaUsesOrthodoxEaster(t *testing.T) {
+	year := 2026
+	want := computus.EasterWithMethod(year, computus.Orthodox).Format("2006-01-02")
+
+	for _, h := range Bulgaria.Observed(year) {
+		if h.Name == "Easter Sunday" {
+			if got := h.Date.Format("2006-01-02"); got != want {
+				t.Errorf("Bulgaria Easter Sunday = %s, want %s (Orthodox)", got, want)
+			}
+			return
+		}
+	}
+	t.Fatal("Bulgaria.Observed did not include Easter Sunday")
+}
+
+func TestHolidayTypeDistinguishesCivilAndReligious(t *testing.T) {
+	for _, h := range Germany.Observed(2026) {
+		if h.Name == "Labour Day" && h.Type != Civil {
+			t.Errorf("Germany Labour Day.Type = %v, want Civil", h.Type)
+		}
+		if h.Name == "Christmas Day" && h.Type != Religious {
+			t.Errorf("Germany Christmas Day.Type = %v, want Religious", h.Type)
+		}
+	}
+}
+
+func TestSaturdayOrSundayToMonday(t *testing.T) {
+	saturday := time.Date(2026, time.January, 3, 0, 0, 0, 0, time.UTC)
+	if saturday.Weekday() != time.Saturday {
+		t.Fatalf("test fixture date %s is not a Saturday", saturday.Format("2006-01-02"))
+	}
+	if got := saturdayOrSundayToMonday(saturday); got.Weekday() != time.Monday || !got.After(saturday) {
+		t.Errorf("saturdayOrSundayToMonday(%s) = %s, want the following Monday", saturday.Format("2006-01-02"), got.Format("2006-01-02"))
+	}
+
+	sunday := saturday.AddDate(0, 0, 1)
+	if got := saturdayOrSundayToMonday(sunday); got.Weekday() != time.Monday {
+		t.Errorf("saturdayOrSundayToMonday(%s) = %s, want the following Monday", sunday.Format("2006-01-02"), got.Format("2006-01-02"))
+	}
+
+	weekday := saturday.AddDate(0, 0, 2) // Monday
+	if got := saturdayOrSundayToMonday(weekday); !got.Equal(weekday) {
+		t.Errorf("saturdayOrSundayToMonday(%s) = %s, want no change", weekday.Format("2006-01-02"), got.Format("2006-01-02"))
+	}
+}
+
+func TestUnitedStatesObservedSubstitution(t *testing.T) {
+	for _, h := range UnitedStates.Observed(2026) {
+		switch h.Date.Weekday() {
+		case time.Saturday:
+			if h.Observed.Weekday() != time.Friday {
+				t.Errorf("%s falls on a Saturday but Observed is %s", h.Name, h.Observed.Weekday())
+			}
+		case time.Sunday:
+			if h.Observed.Weekday() != time.Monday {
+				t.Errorf("%s falls on a Sunday but Observed is %s", h.Name, h.Observed.Weekday())
+			}
+		default:
+			if !h.Observed.Equal(h.Date) {
+				t.Errorf("%s falls on a weekday but Observed (%s) != Date (%s)", h.Name, h.Observed, h.Date)
+			}
+		}
+	}
+}
+
+func TestSaturdayToPrecedingFridayOrSundayToMonday(t *testing.T) {
+	saturday := time.Date(2026, time.January, 3, 0, 0, 0, 0, time.UTC)
+	if saturday.Weekday() != time.Saturday {
+		t.Fatalf("test fixture date %s is not a Saturday", saturday.Format("2006-01-02"))
+	}
+	if got := saturdayToPrecedingFridayOrSundayToMonday(saturday); got.Weekday() != time.Friday || !got.Before(saturday) {
+		t.Errorf("saturdayToPrecedingFridayOrSundayToMonday(%s) = %s, want the preceding Friday", saturday.Format("2006-01-02"), got.Format("2006-01-02"))
+	}
+
+	sunday := saturday.AddDate(0, 0, 1)
+	if got := saturdayToPrecedingFridayOrSundayToMonday(sunday); got.Weekday() != time.Monday {
+		t.Errorf("saturdayToPrecedingFridayOrSundayToMonday(%s) = %s, want the following Monday", sunday.Format("2006-01-02"), got.Format("2006-01-02"))
+	}
+
+	weekday := saturday.AddDate(0, 0, 2) // Monday
+	if got := saturdayToPrecedingFridayOrSundayToMonday(weekday); !got.Equal(weekday) {
+		t.Errorf("saturdayToPrecedingFridayOrSundayToMonday(%s) = %s, want no change", weekday.Format("2006-01-02"), got.Format("2006-01-02"))
+	}
+}
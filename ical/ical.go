@@ -0,0 +1,208 @@
+// Package ical serializes computed liturgical celebrations into an
+// RFC 5545 VCALENDAR stream.
+package ical
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/CodeZea1ot/computus"
+	"github.com/CodeZea1ot/computus/liturgy"
+	"github.com/CodeZea1ot/computus/rubric"
+)
+
+// FeastSet is a bitmask selecting which feast sets Export includes.
+type FeastSet int
+
+const (
+	// EasterRelative includes the temporal cycle's own celebrations
+	// (Sundays, Ember days, and the other moveable feasts).
+	EasterRelative FeastSet = 1 << iota
+	// Sanctoral includes fixed-date feasts resolved against the temporal
+	// cycle.
+	Sanctoral
+
+	// All includes every feast set.
+	All = EasterRelative | Sanctoral
+)
+
+// Options configures an Export call.
+type Options struct {
+	// Sources selects which feast sets to include. The zero value is
+	// equivalent to All.
+	Sources FeastSet
+
+	// MinRank filters sanctoral feasts to those at or above this rank. The
+	// zero value applies no filter. It has no effect on EasterRelative
+	// celebrations, which are not given a comparable rank outside of a
+	// liturgy.Calendar.
+	MinRank rubric.Rank
+
+	// Translate, if non-nil, is called with each celebration's name to
+	// produce the exported SUMMARY, allowing callers to localize it.
+	Translate func(name string) string
+}
+
+type event struct {
+	name string
+	date time.Time
+}
+
+// Export writes an RFC 5545 VCALENDAR stream covering every day from from
+// to to, inclusive, to w.
+//
+// If cal is non-nil, celebrations are resolved from it; opts.Sources then
+// selects whether the resolved temporal celebration, the resolved
+// sanctoral celebration, or both, are exported for each date. If cal is
+// nil, Export falls back to the raw computus.RelativeToEasterDays table
+// (there being no Sanctorale to merge, Sanctoral is implicitly excluded in
+// that mode).
+func Export(w io.Writer, from, to time.Time, cal *liturgy.Calendar, opts Options) error {
+	from, to = truncateToDate(from), truncateToDate(to)
+	sources := opts.Sources
+	if sources == 0 {
+		sources = All
+	}
+
+	bw := bufio.NewWriter(w)
+	writeLine(bw, "BEGIN:VCALENDAR")
+	writeLine(bw, "VERSION:2.0")
+	writeLine(bw, "PRODID:-//computus//ical//EN")
+	writeLine(bw, "CALSCALE:GREGORIAN")
+
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		for _, e := range eventsForDate(d, cal, sources, opts.MinRank) {
+			writeEvent(bw, e, opts.Translate)
+		}
+	}
+
+	writeLine(bw, "END:VCALENDAR")
+	return bw.Flush()
+}
+
+func eventsForDate(d time.Time, cal *liturgy.Calendar, sources FeastSet, minRank rubric.Rank) []event {
+	if cal == nil {
+		return easterRelativeEventsForDate(d)
+	}
+
+	day := cal.Day(d)
+	var out []event
+	include := func(c liturgy.Celebration) bool {
+		switch c.Source {
+		case liturgy.FromTemporale:
+			return sources&EasterRelative != 0
+		case liturgy.FromSanctorale:
+			return sources&Sanctoral != 0 && c.Rank.Precedence() >= minRank.Precedence()
+		default:
+			return false
+		}
+	}
+
+	if include(day.Celebrated) {
+		out = append(out, event{name: day.Celebrated.Name, date: d})
+	}
+	for _, c := range day.Commemorations {
+		if include(c) {
+			out = append(out, event{name: c.Name, date: d})
+		}
+	}
+	return out
+}
+
+func easterRelativeEventsForDate(d time.Time) []event {
+	easter := computus.Easter(d.Year())
+	var out []event
+	for _, r := range computus.RelativeToEasterDays {
+		if easter.AddDate(0, 0, r.Offset).Equal(d) {
+			out = append(out, event{name: r.Name, date: d})
+		}
+	}
+	return out
+}
+
+func writeEvent(w *bufio.Writer, e event, translate func(string) string) {
+	summary := e.name
+	if translate != nil {
+		summary = translate(e.name)
+	}
+
+	writeLine(w, "BEGIN:VEVENT")
+	writeLine(w, "UID:"+uid(e.name, e.date.Year()))
+	writeLine(w, "DTSTAMP:"+time.Now().UTC().Format("20060102T150405Z"))
+	writeLine(w, "DTSTART;VALUE=DATE:"+e.date.Format("20060102"))
+	writeLine(w, "DTEND;VALUE=DATE:"+e.date.AddDate(0, 0, 1).Format("20060102"))
+	writeLine(w, "SUMMARY:"+escapeText(summary))
+	writeLine(w, "END:VEVENT")
+}
+
+// uid derives a stable event UID from a celebration's name and year, so
+// that re-exporting the same range does not churn calendar subscriptions.
+func uid(name string, year int) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("%s|%d", name, year)))
+	return fmt.Sprintf("%x@computus", sum)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\n", `\n`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// foldLineOctets is the maximum number of octets RFC 5545 §3.1 allows on a
+// content line, excluding the trailing CRLF.
+const foldLineOctets = 75
+
+// writeLine writes s as one or more RFC 5545 content lines, folding it at
+// foldLineOctets octets per line. Continuation lines are introduced by a
+// single leading space, and folding only ever splits between UTF-8 code
+// points, never inside one.
+func writeLine(w *bufio.Writer, s string) {
+	line := []byte(s)
+	first := true
+	for {
+		limit := foldLineOctets
+		if !first {
+			limit-- // leading space on continuation lines counts against the limit
+		}
+		if len(line) <= limit {
+			if !first {
+				w.WriteByte(' ')
+			}
+			w.Write(line)
+			w.WriteString("\r\n")
+			return
+		}
+
+		cut := limit
+		for cut > 0 && isUTF8Continuation(line[cut]) {
+			cut--
+		}
+		if !first {
+			w.WriteByte(' ')
+		}
+		w.Write(line[:cut])
+		w.WriteString("\r\n")
+		line = line[cut:]
+		first = false
+	}
+}
+
+// isUTF8Continuation reports whether b is a UTF-8 continuation byte
+// (10xxxxxx), i.e. not the start of a new code point.
+func isUTF8Continuation(b byte) bool {
+	return b&0xC0 == 0x80
+}
+
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,173 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CodeZea1ot/computus"
+	"github.com/CodeZea1ot/computus/liturgy"
+	"github.com/CodeZea1ot/computus/sanctoral"
+	"github.com/CodeZea1ot/computus/temporal"
+)
+
+// parseVCalendar does just enough RFC 5545 parsing to assert structural
+// validity: balanced BEGIN/END blocks, and that every VEVENT carries the
+// required UID/DTSTAMP/DTSTART/SUMMARY properties.
+func parseVCalendar(t *testing.T, data string) (summaries []string) {
+	t.Helper()
+
+	lines := strings.Split(data, "\r\n")
+	if lines[0] != "BEGIN:VCALENDAR" {
+		t.Fatalf("first line = %q, want BEGIN:VCALENDAR", lines[0])
+	}
+
+	var depth int
+	var inEvent bool
+	var haveUID, haveDTStamp, haveDTStart, haveSummary bool
+
+	for _, line := range lines {
+		switch {
+		case line == "BEGIN:VCALENDAR":
+			depth++
+		case line == "END:VCALENDAR":
+			depth--
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+			haveUID, haveDTStamp, haveDTStart, haveSummary = false, false, false, false
+		case line == "END:VEVENT":
+			if !haveUID || !haveDTStamp || !haveDTStart || !haveSummary {
+				t.Fatalf("VEVENT missing a required property: UID=%v DTSTAMP=%v DTSTART=%v SUMMARY=%v",
+					haveUID, haveDTStamp, haveDTStart, haveSummary)
+			}
+			inEvent = false
+		case inEvent && strings.HasPrefix(line, "UID:"):
+			haveUID = true
+		case inEvent && strings.HasPrefix(line, "DTSTAMP:"):
+			haveDTStamp = true
+		case inEvent && strings.HasPrefix(line, "DTSTART;VALUE=DATE:"):
+			haveDTStart = true
+		case inEvent && strings.HasPrefix(line, "SUMMARY:"):
+			haveSummary = true
+			summaries = append(summaries, strings.TrimPrefix(line, "SUMMARY:"))
+		}
+	}
+
+	if depth != 0 {
+		t.Fatalf("unbalanced BEGIN/END:VCALENDAR, depth = %d", depth)
+	}
+	return summaries
+}
+
+func TestExportEasterRelativeOnly(t *testing.T) {
+	var buf bytes.Buffer
+	from := computus.Easter(2026)
+	to := from.AddDate(0, 0, 1)
+
+	if err := Export(&buf, from, to, nil, Options{}); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	summaries := parseVCalendar(t, buf.String())
+	found := false
+	for _, s := range summaries {
+		if s == "Easter Monday" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("summaries = %v, want to include Easter Monday", summaries)
+	}
+}
+
+func TestExportStableUID(t *testing.T) {
+	var first, second bytes.Buffer
+	from := computus.Easter(2026).AddDate(0, 0, 1) // Easter Monday
+	to := from
+
+	if err := Export(&first, from, to, nil, Options{}); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	if err := Export(&second, from, to, nil, Options{}); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	extractUID := func(s string) string {
+		for _, line := range strings.Split(s, "\r\n") {
+			if strings.HasPrefix(line, "UID:") {
+				return line
+			}
+		}
+		return ""
+	}
+	firstUID := extractUID(first.String())
+	if firstUID == "" {
+		t.Fatal("first export produced no UID")
+	}
+	if firstUID != extractUID(second.String()) {
+		t.Error("re-exporting the same range produced a different UID")
+	}
+}
+
+func TestExportWithCalendarFiltersByMinRank(t *testing.T) {
+	tp := temporal.NewTemporale(2026)
+	cal := liturgy.NewCalendar(tp, sanctoral.Universal())
+
+	var buf bytes.Buffer
+	from := time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC)
+	to := from
+
+	err := Export(&buf, from, to, cal, Options{Sources: Sanctoral, MinRank: "Greater Double"})
+	if err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	summaries := parseVCalendar(t, buf.String())
+	if len(summaries) != 0 {
+		t.Errorf("summaries = %v, want none above Greater Double rank", summaries)
+	}
+}
+
+func TestExportTranslate(t *testing.T) {
+	var buf bytes.Buffer
+	from := computus.Easter(2026).AddDate(0, 0, 1) // Easter Monday
+	to := from
+
+	opts := Options{Translate: func(name string) string { return strings.ToUpper(name) }}
+	if err := Export(&buf, from, to, nil, opts); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "SUMMARY:EASTER MONDAY") {
+		t.Errorf("output does not contain a translated SUMMARY: %s", buf.String())
+	}
+}
+
+func TestExportFoldsLongSummaryLines(t *testing.T) {
+	var buf bytes.Buffer
+	from := computus.Easter(2026).AddDate(0, 0, 1) // Easter Monday
+	to := from
+
+	longName := "Easter Monday, the Day After the Most Solemn and Holy Feast of the Resurrection of Our Lord Jesus Christ"
+	opts := Options{Translate: func(string) string { return longName }}
+	if err := Export(&buf, from, to, nil, opts); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	var unfolded strings.Builder
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("content line %q is %d octets, want at most 75", line, len(line))
+		}
+		if strings.HasPrefix(line, " ") {
+			unfolded.WriteString(strings.TrimPrefix(line, " "))
+		} else {
+			unfolded.WriteString(line)
+		}
+	}
+
+	if !strings.Contains(unfolded.String(), "SUMMARY:"+escapeText(longName)) {
+		t.Errorf("unfolded output does not contain the full SUMMARY: %s", unfolded.String())
+	}
+}
@@ -0,0 +1,139 @@
+// Package liturgy resolves a temporal.Temporale against a
+// sanctoral.Sanctorale to answer, for any given date, what is actually
+// celebrated.
+package liturgy
+
+import (
+	"iter"
+	"time"
+
+	"github.com/CodeZea1ot/computus/rubric"
+	"github.com/CodeZea1ot/computus/sanctoral"
+	"github.com/CodeZea1ot/computus/temporal"
+)
+
+// Source identifies whether a Celebration originated from the temporal
+// cycle or from a fixed-date sanctoral feast.
+type Source int
+
+const (
+	FromTemporale Source = iota
+	FromSanctorale
+)
+
+// Celebration is a single named liturgical observance considered for a
+// date, together with enough information to resolve precedence against
+// other candidates for the same date.
+type Celebration struct {
+	Name   string
+	Rank   rubric.Rank
+	Source Source
+}
+
+// Day describes everything relevant to a single calendar date: whichever
+// celebration takes precedence, anything it demotes to a commemoration,
+// and the season/color/weekday context needed to render it.
+type Day struct {
+	Date           time.Time
+	Weekday        time.Weekday
+	Season         temporal.Season
+	Color          temporal.LiturgicalColor
+	Celebrated     Celebration
+	Commemorations []Celebration
+}
+
+// Calendar resolves a Temporale (the moveable, Christmas/Easter-anchored
+// cycle) against a Sanctorale (fixed-date feasts) per the pre-1962
+// rubrics: on any given date, the higher-ranked celebration is celebrated
+// and the other is demoted to a commemoration.
+type Calendar struct {
+	temporale  *temporal.Temporale
+	sanctorale *sanctoral.Sanctorale
+}
+
+// NewCalendar builds a Calendar from an already-constructed Temporale and
+// Sanctorale.
+func NewCalendar(t *temporal.Temporale, s *sanctoral.Sanctorale) *Calendar {
+	return &Calendar{temporale: t, sanctorale: s}
+}
+
+// Day resolves the celebration for a single date.
+func (c *Calendar) Day(date time.Time) Day {
+	y, m, d := date.Date()
+	truncated := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	temporalCelebration := c.temporale.DayOfSeason(truncated)
+	day := Day{
+		Date:       truncated,
+		Weekday:    truncated.Weekday(),
+		Season:     temporalCelebration.Season,
+		Color:      temporalCelebration.Color,
+		Celebrated: temporalToCelebration(temporalCelebration),
+	}
+
+	if c.suppressesSanctoral(truncated) {
+		return day
+	}
+
+	fixed := c.sanctorale.On(int(m), d)
+	if len(fixed) == 0 {
+		return day
+	}
+
+	primary := fixedToCelebration(fixed[0])
+	if day.Celebrated.Rank.Outranks(primary.Rank) {
+		day.Commemorations = append(day.Commemorations, celebrationsFromFixed(fixed)...)
+		return day
+	}
+
+	day.Celebrated = primary
+	day.Commemorations = append(day.Commemorations, temporalToCelebration(temporalCelebration))
+	day.Commemorations = append(day.Commemorations, celebrationsFromFixed(fixed[1:])...)
+	return day
+}
+
+// Year returns an iterator over every Day in the given civil year.
+func (c *Calendar) Year(year int) iter.Seq[Day] {
+	return func(yield func(Day) bool) {
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			if !yield(c.Day(d)) {
+				return
+			}
+		}
+	}
+}
+
+// suppressesSanctoral reports whether sanctoral feasts are suppressed
+// entirely on the given date, regardless of rank: on Ash Wednesday,
+// throughout Holy Week, and throughout the Octave of Easter, no fixed
+// feast is celebrated or even commemorated.
+func (c *Calendar) suppressesSanctoral(date time.Time) bool {
+	if date.Equal(c.temporale.AshWednesday()) {
+		return true
+	}
+	if !date.Before(c.temporale.PalmSunday()) && date.Before(c.temporale.Easter()) {
+		return true
+	}
+	if !date.Before(c.temporale.Easter()) && !date.After(c.temporale.OctaveOfEaster()) {
+		return true
+	}
+	return false
+}
+
+func temporalToCelebration(tc temporal.Celebration) Celebration {
+	return Celebration{Name: tc.Name, Rank: tc.Rank, Source: FromTemporale}
+}
+
+func fixedToCelebration(fd sanctoral.FixedDay) Celebration {
+	return Celebration{Name: fd.Name, Rank: fd.Rank, Source: FromSanctorale}
+}
+
+func celebrationsFromFixed(fds []sanctoral.FixedDay) []Celebration {
+	out := make([]Celebration, len(fds))
+	for i, fd := range fds {
+		out[i] = fixedToCelebration(fd)
+	}
+	return out
+}
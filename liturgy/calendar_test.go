@@ -0,0 +1,80 @@
+package liturgy
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CodeZea1ot/computus/sanctoral"
+	"github.com/CodeZea1ot/computus/temporal"
+)
+
+const testSanctorale = `
+= 1 =
+1 f : Circumcision of Our Lord
+15 s : St. Simple Confessor
+
+= 3 =
+19 f : St. Joseph, Spouse of the Blessed Virgin Mary
+
+= 4 =
+2 f : St. Francis of Paola
+`
+
+func newTestCalendar(t *testing.T, year int) *Calendar {
+	t.Helper()
+	s, err := sanctoral.LoadReader(strings.NewReader(testSanctorale))
+	if err != nil {
+		t.Fatalf("LoadReader returned error: %v", err)
+	}
+	return NewCalendar(temporal.NewTemporale(year), s)
+}
+
+func TestCalendarDaySanctoralOutranksOrdinaryWeekday(t *testing.T) {
+	cal := newTestCalendar(t, 2026)
+
+	day := cal.Day(time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC))
+	if day.Celebrated.Name != "St. Simple Confessor" || day.Celebrated.Source != FromSanctorale {
+		t.Errorf("Day(Jan 15).Celebrated = %+v, want St. Simple Confessor from the Sanctorale", day.Celebrated)
+	}
+	if len(day.Commemorations) != 1 || day.Commemorations[0].Source != FromTemporale {
+		t.Errorf("Day(Jan 15).Commemorations = %+v, want the demoted temporal day", day.Commemorations)
+	}
+}
+
+func TestCalendarDayNoSanctoralFeast(t *testing.T) {
+	cal := newTestCalendar(t, 2026)
+
+	day := cal.Day(time.Date(2026, time.January, 20, 0, 0, 0, 0, time.UTC))
+	if day.Celebrated.Source != FromTemporale {
+		t.Errorf("Day(Jan 20).Celebrated.Source = %v, want FromTemporale", day.Celebrated.Source)
+	}
+	if len(day.Commemorations) != 0 {
+		t.Errorf("Day(Jan 20).Commemorations = %+v, want none", day.Commemorations)
+	}
+}
+
+func TestCalendarDaySuppressesSanctoralDuringHolyWeek(t *testing.T) {
+	cal := newTestCalendar(t, 2026)
+	tp := temporal.NewTemporale(2026)
+
+	day := cal.Day(tp.HolyThursday())
+	if len(day.Commemorations) != 0 {
+		t.Errorf("Day(Holy Thursday).Commemorations = %+v, want none", day.Commemorations)
+	}
+	if day.Celebrated.Source != FromTemporale {
+		t.Errorf("Day(Holy Thursday).Celebrated.Source = %v, want FromTemporale", day.Celebrated.Source)
+	}
+}
+
+func TestCalendarYearIteratesEveryDay(t *testing.T) {
+	cal := newTestCalendar(t, 2026)
+
+	count := 0
+	for range cal.Year(2026) {
+		count++
+	}
+	if count != 365 {
+		t.Errorf("Year(2026) iterated %d days, want 365", count)
+	}
+}
@@ -9,3 +9,27 @@ const (
 	Semidouble    Rank = "Semidouble"
 	Simple        Rank = "Simple"
 )
+
+// precedence orders the known ranks from lowest to highest.
+var precedence = map[Rank]int{
+	Simple:        0,
+	Semidouble:    1,
+	Double:        2,
+	GreaterDouble: 3,
+}
+
+// Precedence returns r's relative precedence, from lowest (0) upward. A
+// Rank with a greater Precedence takes priority over one with a lesser
+// Precedence when two celebrations fall on the same date. It returns -1 for
+// a Rank that is not one of the constants defined in this package.
+func (r Rank) Precedence() int {
+	if p, ok := precedence[r]; ok {
+		return p
+	}
+	return -1
+}
+
+// Outranks reports whether r takes precedence over other.
+func (r Rank) Outranks(other Rank) bool {
+	return r.Precedence() > other.Precedence()
+}
@@ -19,3 +19,31 @@ func TestRankConstants(t *testing.T) {
 		}
 	}
 }
+
+func TestRankPrecedenceOrdering(t *testing.T) {
+	ranks := []Rank{Simple, Semidouble, Double, GreaterDouble}
+	for i := 1; i < len(ranks); i++ {
+		if ranks[i].Precedence() <= ranks[i-1].Precedence() {
+			t.Errorf("%v.Precedence() = %d, want greater than %v.Precedence() = %d",
+				ranks[i], ranks[i].Precedence(), ranks[i-1], ranks[i-1].Precedence())
+		}
+	}
+}
+
+func TestRankPrecedenceUnknown(t *testing.T) {
+	if got := Rank("Nonexistent").Precedence(); got != -1 {
+		t.Errorf("Rank(%q).Precedence() = %d, want -1", "Nonexistent", got)
+	}
+}
+
+func TestRankOutranks(t *testing.T) {
+	if !GreaterDouble.Outranks(Simple) {
+		t.Error("GreaterDouble.Outranks(Simple) = false, want true")
+	}
+	if Simple.Outranks(GreaterDouble) {
+		t.Error("Simple.Outranks(GreaterDouble) = true, want false")
+	}
+	if Double.Outranks(Double) {
+		t.Error("Double.Outranks(Double) = true, want false")
+	}
+}
@@ -0,0 +1,160 @@
+package sanctoral
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/CodeZea1ot/computus/rubric"
+)
+
+// Sanctorale is a calendar of fixed-date feasts and fasts, keyed by month
+// and day. A single date may carry more than one FixedDay: the first entry
+// loaded for a date is its primary celebration, and any further entries are
+// commemorations of it.
+type Sanctorale struct {
+	days map[[2]int][]FixedDay
+}
+
+func newSanctorale() *Sanctorale {
+	return &Sanctorale{days: make(map[[2]int][]FixedDay)}
+}
+
+func (s *Sanctorale) add(fd FixedDay) {
+	key := [2]int{fd.Month, fd.Day}
+	s.days[key] = append(s.days[key], fd)
+}
+
+// On returns the fixed-date feasts assigned to the given month and day, in
+// the order they were loaded: the primary celebration first, followed by
+// any commemorations. It returns nil if no feast is assigned to that date.
+func (s *Sanctorale) On(month, day int) []FixedDay {
+	return s.days[[2]int{month, day}]
+}
+
+var (
+	monthHeaderLine = regexp.MustCompile(`^=\s*(\d{1,2})\s*=$`)
+	feastLine       = regexp.MustCompile(`^(\d{1,2})\s+([fmso])\s*:\s*(.+)$`)
+)
+
+// rankToken maps the single-letter rank tokens used in the text format to
+// a rubric.Rank and whether the feast is an optional memorial.
+func rankToken(tok string) (rubric.Rank, bool, error) {
+	switch tok {
+	case "f":
+		return rubric.Double, false, nil
+	case "m":
+		return rubric.Semidouble, false, nil
+	case "s":
+		return rubric.Simple, false, nil
+	case "o":
+		return rubric.Simple, true, nil
+	default:
+		return "", false, fmt.Errorf("sanctoral: unknown rank token %q", tok)
+	}
+}
+
+// LoadReader parses the plain-text sanctoral format from r and returns the
+// resulting Sanctorale.
+//
+// The format is line-oriented: a month header of the form "= 1 =" (1 for
+// January through 12 for December) introduces every feast line that
+// follows, until the next month header. A feast line has the form
+//
+//	25 f : Conversion of St. Paul, Apostle
+//
+// where the first field is the day of the month, and the second is a
+// single-letter rank token: f (feast/Double), m (memoria/Semidouble),
+// s (simple), or o (optional memorial). Blank lines and lines beginning
+// with "#" are ignored.
+func LoadReader(r io.Reader) (*Sanctorale, error) {
+	s := newSanctorale()
+	scanner := bufio.NewScanner(r)
+
+	month := 0
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if m := monthHeaderLine.FindStringSubmatch(line); m != nil {
+			parsed, err := strconv.Atoi(m[1])
+			if err != nil || parsed < 1 || parsed > 12 {
+				return nil, fmt.Errorf("sanctoral: line %d: invalid month header %q", lineNo, line)
+			}
+			month = parsed
+			continue
+		}
+
+		m := feastLine.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("sanctoral: line %d: malformed entry %q", lineNo, line)
+		}
+		if month == 0 {
+			return nil, fmt.Errorf("sanctoral: line %d: feast entry before any month header", lineNo)
+		}
+
+		day, err := strconv.Atoi(m[1])
+		if err != nil || day < 1 || day > 31 {
+			return nil, fmt.Errorf("sanctoral: line %d: invalid day %q", lineNo, m[1])
+		}
+		rank, optional, err := rankToken(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("sanctoral: line %d: %w", lineNo, err)
+		}
+
+		s.add(FixedDay{
+			Name:     m[3],
+			Month:    month,
+			Day:      day,
+			Rank:     rank,
+			Optional: optional,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// LoadFile parses the plain-text sanctoral format from the file at path.
+func LoadFile(path string) (*Sanctorale, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadReader(f)
+}
+
+//go:embed data/universal.txt
+var universalData string
+
+var (
+	universalOnce sync.Once
+	universal     *Sanctorale
+)
+
+// Universal returns the bundled universal Roman calendar: a representative
+// subset of the fixed-date feasts common to the whole Latin rite,
+// independent of any regional proper. The returned Sanctorale is shared and
+// must not be mutated.
+func Universal() *Sanctorale {
+	universalOnce.Do(func() {
+		s, err := LoadReader(strings.NewReader(universalData))
+		if err != nil {
+			panic("sanctoral: embedded universal calendar is malformed: " + err.Error())
+		}
+		universal = s
+	})
+	return universal
+}
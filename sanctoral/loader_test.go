@@ -0,0 +1,112 @@
+package sanctoral
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/CodeZea1ot/computus/rubric"
+)
+
+const sampleCalendar = `
+= 1 =
+1 f : Circumcision of Our Lord
+25 m : Conversion of St. Paul, Apostle
+
+= 9 =
+29 f : St. Michael the Archangel
+29 o : Sts. Dorotheus and Gorgonius, Martyrs
+`
+
+func TestLoadReaderParsesFeasts(t *testing.T) {
+	s, err := LoadReader(strings.NewReader(sampleCalendar))
+	if err != nil {
+		t.Fatalf("LoadReader returned error: %v", err)
+	}
+
+	got := s.On(1, 1)
+	if len(got) != 1 {
+		t.Fatalf("On(1, 1) returned %d entries, want 1", len(got))
+	}
+	if got[0].Name != "Circumcision of Our Lord" || got[0].Rank != rubric.Double || got[0].Optional {
+		t.Errorf("On(1, 1)[0] = %+v, want Circumcision of Our Lord/Double/non-optional", got[0])
+	}
+}
+
+func TestLoadReaderDuplicateDateIsPrimaryPlusCommemoration(t *testing.T) {
+	s, err := LoadReader(strings.NewReader(sampleCalendar))
+	if err != nil {
+		t.Fatalf("LoadReader returned error: %v", err)
+	}
+
+	got := s.On(9, 29)
+	if len(got) != 2 {
+		t.Fatalf("On(9, 29) returned %d entries, want 2", len(got))
+	}
+	if got[0].Name != "St. Michael the Archangel" || got[0].Rank != rubric.Double {
+		t.Errorf("On(9, 29)[0] (primary) = %+v, want St. Michael the Archangel/Double", got[0])
+	}
+	if got[1].Name != "Sts. Dorotheus and Gorgonius, Martyrs" || !got[1].Optional {
+		t.Errorf("On(9, 29)[1] (commemoration) = %+v, want an optional memorial", got[1])
+	}
+}
+
+func TestLoadReaderUnknownDate(t *testing.T) {
+	s, err := LoadReader(strings.NewReader(sampleCalendar))
+	if err != nil {
+		t.Fatalf("LoadReader returned error: %v", err)
+	}
+	if got := s.On(6, 15); got != nil {
+		t.Errorf("On(6, 15) = %+v, want nil", got)
+	}
+}
+
+func TestLoadReaderMalformedEntry(t *testing.T) {
+	_, err := LoadReader(strings.NewReader("= 1 =\nnot a valid line\n"))
+	if err == nil {
+		t.Error("expected an error for a malformed entry, got nil")
+	}
+}
+
+func TestLoadReaderFeastBeforeMonthHeader(t *testing.T) {
+	_, err := LoadReader(strings.NewReader("1 f : St. Someone\n"))
+	if err == nil {
+		t.Error("expected an error for a feast entry before any month header, got nil")
+	}
+}
+
+func TestLoadReaderUnknownRankToken(t *testing.T) {
+	_, err := LoadReader(strings.NewReader("= 1 =\n1 x : St. Someone\n"))
+	if err == nil {
+		t.Error("expected an error for an unknown rank token, got nil")
+	}
+}
+
+// TestUniversalRoundTrip verifies that the embedded universal calendar
+// parses cleanly and that a sample of its known entries resolve correctly.
+func TestUniversalRoundTrip(t *testing.T) {
+	u := Universal()
+
+	christmas := u.On(12, 25)
+	if len(christmas) != 1 || christmas[0].Name != "Nativity of Our Lord" || christmas[0].Rank != rubric.Double {
+		t.Errorf("On(12, 25) = %+v, want Nativity of Our Lord/Double", christmas)
+	}
+
+	michaelmas := u.On(9, 29)
+	if len(michaelmas) != 2 {
+		t.Fatalf("On(9, 29) returned %d entries, want 2", len(michaelmas))
+	}
+	if michaelmas[0].Optional {
+		t.Errorf("On(9, 29)[0] (primary) reported Optional=true, want false")
+	}
+	if !michaelmas[1].Optional {
+		t.Errorf("On(9, 29)[1] (commemoration) reported Optional=false, want true")
+	}
+}
+
+// TestUniversalIsCached verifies that Universal returns the same instance
+// on repeated calls rather than re-parsing the embedded data every time.
+func TestUniversalIsCached(t *testing.T) {
+	if Universal() != Universal() {
+		t.Error("Universal() returned different instances across calls")
+	}
+}
@@ -0,0 +1,454 @@
+// Package temporal models the temporal cycle of the liturgical year: the
+// moveable seasons, weeks, and colors anchored on Christmas and Easter, as
+// distinct from the fixed-date feasts handled by the sanctoral package.
+package temporal
+
+import (
+	"iter"
+	"strconv"
+	"time"
+
+	"github.com/CodeZea1ot/computus"
+	"github.com/CodeZea1ot/computus/rubric"
+)
+
+// RelativeToEaster represents a moveable feast or fast whose date is
+// relative to Easter. It mirrors computus.RelativeToEaster, but is kept
+// local to this package so that Temporale's season and week bookkeeping
+// does not depend on computus's exported table shifting underneath it.
+type RelativeToEaster struct {
+	Name   string // Name of the feast/fast
+	Offset int    // Days relative to Easter (negative = before, positive = after)
+}
+
+const (
+	EmberWedLent = "Ember Wednesday (Lent)"
+	EmberFriLent = "Ember Friday (Lent)"
+	EmberSatLent = "Ember Saturday (Lent)"
+	EmberWedPent = "Ember Wednesday (Pentecost)"
+	EmberFriPent = "Ember Friday (Pentecost)"
+	EmberSatPent = "Ember Saturday (Pentecost)"
+)
+
+// RelativeToEasterDays is this package's table of movable feasts/fasts,
+// looked up by relativeToEaster and mustRelativeToEaster. Temporale does not
+// read it: its season boundaries are computed directly from computus.Easter.
+var RelativeToEasterDays = []RelativeToEaster{
+	{"Septuagesima Sunday", -63},
+	{"Sexagesima Sunday", -56},
+	{"Quinguagesima Sunday", -49},
+	{"Ash Wednesday", -46},
+	{EmberWedLent, -39},
+	{EmberFriLent, -37},
+	{EmberSatLent, -36},
+	{"Passion Sunday", -14},
+	{"Palm Sunday", -7},
+	{"Spy Wednesday", -4},
+	{"Holy Thursday", -3},
+	{"Good Friday", -2},
+	{"Holy Saturday", -1},
+	{"Easter Monday", 1},
+	{"Easter Tuesday", 2},
+	{"The Octave of Easter (Low Sunday)", 7},
+	{"Ascension", 39},
+	{"Pentecost", 49},
+	{EmberWedPent, 52},
+	{EmberFriPent, 54},
+	{EmberSatPent, 55},
+	{"Trinity Sunday", 56},
+	{"Corpus Christi", 60},
+}
+
+// relativeToEaster returns the date of a movable feast or fast for the
+// given year, calculated by applying the feast's offset relative to Easter
+// Sunday.
+func relativeToEaster(year int, name string) (time.Time, bool) {
+	for _, r := range RelativeToEasterDays {
+		if r.Name == name {
+			return computus.Easter(year).AddDate(0, 0, r.Offset), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// mustRelativeToEaster panics if the provided name does not correspond to a
+// known feast or fast in RelativeToEasterDays.
+func mustRelativeToEaster(year int, name string) time.Time {
+	if d, ok := relativeToEaster(year, name); ok {
+		return d
+	}
+	panic("temporal: unknown feast/fast: " + name)
+}
+
+// truncateToDate normalizes t to midnight UTC on its calendar date,
+// discarding any time-of-day and location information so that date
+// comparisons are well-defined regardless of the caller's input.
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// firstSundayOfAdvent returns the First Sunday of Advent for the given
+// civil year: the Sunday nearest November 30th (the feast of St. Andrew).
+func firstSundayOfAdvent(year int) time.Time {
+	stAndrew := time.Date(year, time.November, 30, 0, 0, 0, 0, time.UTC)
+	wd := int(stAndrew.Weekday())
+	prevSunday := stAndrew.AddDate(0, 0, -wd)
+	nextSunday := prevSunday.AddDate(0, 0, 7)
+	if stAndrew.Sub(prevSunday) <= nextSunday.Sub(stAndrew) {
+		return prevSunday
+	}
+	return nextSunday
+}
+
+// baptismOfTheLord returns the date on which Christmastide ends: the Sunday
+// falling on or after Epiphany (January 6th), except that if Epiphany
+// itself is a Sunday, it is observed the following Monday.
+func baptismOfTheLord(year int) time.Time {
+	epiphany := time.Date(year, time.January, 6, 0, 0, 0, 0, time.UTC)
+	wd := int(epiphany.Weekday())
+	if wd == 0 {
+		return epiphany.AddDate(0, 0, 1)
+	}
+	return epiphany.AddDate(0, 0, 7-wd)
+}
+
+// Season identifies one of the liturgical seasons of the temporal cycle.
+type Season int
+
+const (
+	Advent Season = iota
+	Christmastide
+	OrdinaryTime
+	Lent
+	Triduum
+	Eastertide
+)
+
+// String returns the conventional English name of the season.
+func (s Season) String() string {
+	switch s {
+	case Advent:
+		return "Advent"
+	case Christmastide:
+		return "Christmastide"
+	case OrdinaryTime:
+		return "Ordinary Time"
+	case Lent:
+		return "Lent"
+	case Triduum:
+		return "Sacred Triduum"
+	case Eastertide:
+		return "Eastertide"
+	default:
+		return "Unknown"
+	}
+}
+
+// LiturgicalColor identifies the color of vestments proper to a day.
+type LiturgicalColor int
+
+const (
+	Green LiturgicalColor = iota
+	Violet
+	White
+	Red
+	Rose
+	Black
+	Gold
+)
+
+// String returns the conventional English name of the color.
+func (c LiturgicalColor) String() string {
+	switch c {
+	case Green:
+		return "Green"
+	case Violet:
+		return "Violet"
+	case White:
+		return "White"
+	case Red:
+		return "Red"
+	case Rose:
+		return "Rose"
+	case Black:
+		return "Black"
+	case Gold:
+		return "Gold"
+	default:
+		return "Unknown"
+	}
+}
+
+// Celebration represents a single day's temporal (Christmas/Easter-cycle)
+// observance.
+type Celebration struct {
+	Name   string
+	Date   time.Time
+	Season Season
+	Week   int
+	Color  LiturgicalColor
+	Rank   rubric.Rank
+}
+
+// Option configures a Temporale at construction time.
+type Option func(*Temporale)
+
+// Temporale computes the liturgical seasons, weeks, and colors of the
+// temporal cycle for a single civil year. Advent and Christmas fall late in
+// the civil year it is constructed for; Lent, Easter, and Pentecost fall
+// within that same civil year, following the usual convention that a
+// calendar year's Temporale spans its own Ash Wednesday through its own
+// Advent.
+type Temporale struct {
+	year int
+
+	adventStart  time.Time
+	christmas    time.Time
+	baptism      time.Time
+	ashWednesday time.Time
+	holyThursday time.Time
+	easter       time.Time
+	pentecost    time.Time
+}
+
+// NewTemporale builds a Temporale for the given civil year.
+func NewTemporale(year int, opts ...Option) *Temporale {
+	easter := computus.Easter(year)
+
+	t := &Temporale{
+		year:         year,
+		adventStart:  firstSundayOfAdvent(year),
+		christmas:    time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC),
+		baptism:      baptismOfTheLord(year),
+		ashWednesday: easter.AddDate(0, 0, -46),
+		holyThursday: easter.AddDate(0, 0, -3),
+		easter:       easter,
+		pentecost:    easter.AddDate(0, 0, 49),
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Year returns the civil year this Temporale was constructed for.
+func (t *Temporale) Year() int { return t.year }
+
+// Easter returns the date of Easter Sunday for this Temporale's year.
+func (t *Temporale) Easter() time.Time { return t.easter }
+
+// AshWednesday returns the date of Ash Wednesday for this Temporale's year.
+func (t *Temporale) AshWednesday() time.Time { return t.ashWednesday }
+
+// PalmSunday returns the date of Palm Sunday for this Temporale's year.
+func (t *Temporale) PalmSunday() time.Time { return t.easter.AddDate(0, 0, -7) }
+
+// HolyThursday returns the date of Holy Thursday for this Temporale's year.
+func (t *Temporale) HolyThursday() time.Time { return t.holyThursday }
+
+// Pentecost returns the date of Pentecost for this Temporale's year.
+func (t *Temporale) Pentecost() time.Time { return t.pentecost }
+
+// OctaveOfEaster returns the date of Low Sunday, the last day of the
+// Octave of Easter, for this Temporale's year.
+func (t *Temporale) OctaveOfEaster() time.Time { return t.easter.AddDate(0, 0, 7) }
+
+// AdventStart returns the date of the First Sunday of Advent for this
+// Temporale's year.
+func (t *Temporale) AdventStart() time.Time { return t.adventStart }
+
+// Season returns the liturgical season in effect on the given date.
+func (t *Temporale) Season(date time.Time) Season {
+	d := truncateToDate(date)
+
+	switch {
+	case !d.Before(t.ashWednesday) && d.Before(t.holyThursday):
+		return Lent
+	case !d.Before(t.holyThursday) && d.Before(t.easter):
+		return Triduum
+	case !d.Before(t.easter) && !d.After(t.pentecost):
+		return Eastertide
+	case !d.Before(t.adventStart) && d.Before(t.christmas):
+		return Advent
+	case !d.Before(t.christmas) || !d.After(t.baptism):
+		return Christmastide
+	default:
+		return OrdinaryTime
+	}
+}
+
+// WeekOfSeason returns the 1-based week number of the given date within its
+// liturgical season, counting from the first day of that season.
+func (t *Temporale) WeekOfSeason(date time.Time) int {
+	d := truncateToDate(date)
+
+	var start time.Time
+	switch t.Season(d) {
+	case Advent:
+		start = t.adventStart
+	case Christmastide:
+		if d.Before(t.christmas) {
+			// The early days of January belong to the Christmastide that
+			// began with the previous civil year's Christmas; there is no
+			// season start within this Temporale to count from.
+			start = time.Date(d.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		} else {
+			start = t.christmas
+		}
+	case Lent:
+		start = t.ashWednesday
+	case Triduum:
+		start = t.holyThursday
+	case Eastertide:
+		start = t.easter
+	default:
+		if d.Before(t.ashWednesday) {
+			start = t.baptism.AddDate(0, 0, 1)
+		} else {
+			start = t.pentecost.AddDate(0, 0, 1)
+		}
+	}
+
+	days := int(d.Sub(start).Hours() / 24)
+	return days/7 + 1
+}
+
+// Color returns the liturgical color proper to the given date.
+func (t *Temporale) Color(date time.Time) LiturgicalColor {
+	d := truncateToDate(date)
+
+	switch t.Season(d) {
+	case Advent:
+		if t.WeekOfSeason(d) == 3 && d.Weekday() == time.Sunday {
+			return Rose
+		}
+		return Violet
+	case Christmastide:
+		return White
+	case Lent:
+		if t.WeekOfSeason(d) == 4 && d.Weekday() == time.Sunday {
+			return Rose
+		}
+		return Violet
+	case Triduum:
+		switch {
+		case d.Equal(t.holyThursday):
+			return White
+		case d.Equal(t.easter.AddDate(0, 0, -1)):
+			return Violet
+		default:
+			return Red
+		}
+	case Eastertide:
+		if d.Equal(t.pentecost) {
+			return Red
+		}
+		return White
+	default:
+		return Green
+	}
+}
+
+// DayOfSeason returns the Celebration for a single date: its season, week
+// within that season, proper color, and rank.
+func (t *Temporale) DayOfSeason(date time.Time) Celebration {
+	d := truncateToDate(date)
+	return Celebration{
+		Name:   t.nameForDate(d),
+		Date:   d,
+		Season: t.Season(d),
+		Week:   t.WeekOfSeason(d),
+		Color:  t.Color(d),
+		Rank:   t.rankForDate(d),
+	}
+}
+
+// rankForDate approximates the rubric.Rank of the temporal cycle's own
+// celebration on a date, for comparison against sanctoral feasts. Sundays
+// of the privileged seasons (Advent, Lent, Eastertide) and every day of the
+// Sacred Triduum outrank all but the highest sanctoral feasts; Sundays of
+// Christmastide and Ordinary Time outrank simple feasts but not Doubles;
+// ordinary weekdays are the lowest-ranked days on the calendar.
+func (t *Temporale) rankForDate(d time.Time) rubric.Rank {
+	season := t.Season(d)
+	sunday := d.Weekday() == time.Sunday
+
+	switch season {
+	case Triduum:
+		return rubric.GreaterDouble
+	case Advent, Lent, Eastertide:
+		if sunday {
+			return rubric.GreaterDouble
+		}
+		return rubric.Semidouble
+	case Christmastide:
+		if sunday {
+			return rubric.Double
+		}
+		return rubric.Semidouble
+	default:
+		if sunday {
+			return rubric.Semidouble
+		}
+		return rubric.Simple
+	}
+}
+
+// nameForDate produces a human-readable label for a date within the
+// temporal cycle, e.g. "3rd Sunday of Advent" or "Wednesday of the 2nd
+// week of Lent".
+func (t *Temporale) nameForDate(d time.Time) string {
+	switch {
+	case d.Equal(t.easter):
+		return "Easter Sunday"
+	case d.Equal(t.holyThursday):
+		return "Holy Thursday"
+	case d.Equal(t.easter.AddDate(0, 0, -2)):
+		return "Good Friday"
+	case d.Equal(t.easter.AddDate(0, 0, -1)):
+		return "Holy Saturday"
+	case d.Equal(t.pentecost):
+		return "Pentecost Sunday"
+	case d.Equal(t.christmas):
+		return "Christmas Day"
+	}
+
+	season := t.Season(d)
+	week := t.WeekOfSeason(d)
+	if d.Weekday() == time.Sunday {
+		return ordinal(week) + " Sunday of " + season.String()
+	}
+	return d.Weekday().String() + " of the " + ordinal(week) + " week of " + season.String()
+}
+
+// ordinal renders n as an English ordinal, e.g. 1 -> "1st".
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+// Days returns an iterator over the Celebration for every day from from to
+// to, inclusive, in calendar order.
+func (t *Temporale) Days(from, to time.Time) iter.Seq[Celebration] {
+	return func(yield func(Celebration) bool) {
+		start := truncateToDate(from)
+		end := truncateToDate(to)
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			if !yield(t.DayOfSeason(d)) {
+				return
+			}
+		}
+	}
+}
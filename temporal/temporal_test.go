@@ -2,8 +2,10 @@ package temporal
 
 import (
 	"testing"
+	"time"
 
 	"github.com/CodeZea1ot/computus"
+	"github.com/CodeZea1ot/computus/rubric"
 )
 
 func TestRelativeToEasterKnownFeasts(t *testing.T) {
@@ -83,3 +85,89 @@ func TestRelativeToEasterInRange(t *testing.T) {
 		checkDaysFromEaster(t, r.Name)
 	}
 }
+
+// TestTemporaleSeasonBoundaries verifies that Season reports the expected
+// season at each boundary of the 2026 temporal cycle.
+func TestTemporaleSeasonBoundaries(t *testing.T) {
+	tp := NewTemporale(2026)
+
+	tests := []struct {
+		name string
+		date time.Time
+		want Season
+	}{
+		{"Ash Wednesday", tp.AshWednesday(), Lent},
+		{"day before Ash Wednesday", tp.AshWednesday().AddDate(0, 0, -1), OrdinaryTime},
+		{"Holy Thursday", tp.HolyThursday(), Triduum},
+		{"Easter Sunday", tp.Easter(), Eastertide},
+		{"Pentecost Sunday", tp.Pentecost(), Eastertide},
+		{"day after Pentecost", tp.Pentecost().AddDate(0, 0, 1), OrdinaryTime},
+		{"First Sunday of Advent", tp.AdventStart(), Advent},
+		{"Christmas Day", tp.christmas, Christmastide},
+		{"January 1st", time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), Christmastide},
+	}
+
+	for _, tt := range tests {
+		if got := tp.Season(tt.date); got != tt.want {
+			t.Errorf("Season(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestTemporaleColorGaudeteLaetare verifies that Gaudete Sunday (3rd Advent)
+// and Laetare Sunday (4th Lent) are correctly colored Rose.
+func TestTemporaleColorGaudeteLaetare(t *testing.T) {
+	tp := NewTemporale(2026)
+
+	gaudete := tp.AdventStart().AddDate(0, 0, 14)
+	if got := tp.Color(gaudete); got != Rose {
+		t.Errorf("Color(Gaudete Sunday) = %v, want %v", got, Rose)
+	}
+
+	laetare := tp.AshWednesday().AddDate(0, 0, 25) // 4th Sunday of Lent
+	if got := tp.Color(laetare); got != Rose {
+		t.Errorf("Color(Laetare Sunday) = %v, want %v", got, Rose)
+	}
+}
+
+// TestTemporaleDayOfSeasonRank verifies that privileged days of the
+// temporal cycle are ranked at or above rubric.GreaterDouble, while an
+// ordinary weekday in Ordinary Time is ranked at rubric.Simple.
+func TestTemporaleDayOfSeasonRank(t *testing.T) {
+	tp := NewTemporale(2026)
+
+	if got := tp.DayOfSeason(tp.Easter()).Rank; got != rubric.GreaterDouble {
+		t.Errorf("DayOfSeason(Easter).Rank = %v, want %v", got, rubric.GreaterDouble)
+	}
+	if got := tp.DayOfSeason(tp.HolyThursday()).Rank; got != rubric.GreaterDouble {
+		t.Errorf("DayOfSeason(Holy Thursday).Rank = %v, want %v", got, rubric.GreaterDouble)
+	}
+
+	ordinaryWeekday := tp.Pentecost().AddDate(0, 0, 3) // a midweek day back in Ordinary Time
+	if ordinaryWeekday.Weekday() == time.Sunday {
+		t.Fatalf("test fixture date %s is unexpectedly a Sunday", ordinaryWeekday.Format("2006-01-02"))
+	}
+	if got := tp.DayOfSeason(ordinaryWeekday).Rank; got != rubric.Simple {
+		t.Errorf("DayOfSeason(%s).Rank = %v, want %v", ordinaryWeekday.Format("2006-01-02"), got, rubric.Simple)
+	}
+}
+
+// TestTemporaleDaysIteration verifies that Days yields one Celebration per
+// day across the requested range, inclusive of both endpoints.
+func TestTemporaleDaysIteration(t *testing.T) {
+	tp := NewTemporale(2026)
+
+	from := tp.Easter()
+	to := tp.Easter().AddDate(0, 0, 6)
+
+	count := 0
+	for c := range tp.Days(from, to) {
+		if c.Season != Eastertide {
+			t.Errorf("Days(%s) season = %v, want %v", c.Date.Format("2006-01-02"), c.Season, Eastertide)
+		}
+		count++
+	}
+	if count != 7 {
+		t.Errorf("Days iterated %d days, want 7", count)
+	}
+}